@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import "context"
+
+// FetchPageFunc fetches a single page of a paginated IBM Cloud collection,
+// starting from the given `start` token. An empty `next` return value
+// signals that there are no more pages.
+type FetchPageFunc[T any] func(ctx context.Context, start string) (items []T, next string, err error)
+
+// PaginateAll walks every page of a paginated collection via fetch and
+// returns the concatenated items. It replaces the classic
+// `start := ""; for { ... start = flex.GetNext(resp.Next) }` loop that is
+// hand-rolled across the VPC data sources; ibm_is_vpc_address_prefix(es)
+// have been migrated to it or to PaginateUntil so far, with the other
+// VPC/subnet data sources
+// that share the pattern left as follow-up migrations.
+func PaginateAll[T any](ctx context.Context, fetch FetchPageFunc[T]) ([]T, error) {
+	all := []T{}
+	start := ""
+	for {
+		items, next, err := fetch(ctx, start)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if next == "" {
+			break
+		}
+		start = next
+	}
+	return all, nil
+}
+
+// PaginateUntil walks the pages of a paginated collection via fetch,
+// stopping as soon as match returns true for an item, and returns that
+// item along with whether a match was found. It avoids fetching and
+// buffering the remaining pages once the lookup is satisfied, which is
+// the common case for name-based data source lookups.
+func PaginateUntil[T any](ctx context.Context, fetch FetchPageFunc[T], match func(T) bool) (T, bool, error) {
+	var zero T
+	start := ""
+	for {
+		items, next, err := fetch(ctx, start)
+		if err != nil {
+			return zero, false, err
+		}
+		for _, item := range items {
+			if match(item) {
+				return item, true, nil
+			}
+		}
+		if next == "" {
+			break
+		}
+		start = next
+	}
+	return zero, false, nil
+}
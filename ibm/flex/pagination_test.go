@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPaginateAll(t *testing.T) {
+	pages := [][]int{{1, 2}, {3, 4}, {5}}
+
+	got, err := PaginateAll(context.Background(), func(ctx context.Context, start string) ([]int, string, error) {
+		idx := 0
+		if start != "" {
+			idx = int(start[0] - 'a' + 1)
+		}
+		if idx >= len(pages) {
+			return nil, "", nil
+		}
+		next := ""
+		if idx+1 < len(pages) {
+			next = string(rune('a' + idx))
+		}
+		return pages[idx], next, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(got) != 5 {
+		t.Fatalf("expected 5 items, got %d: %v", len(got), got)
+	}
+}
+
+func TestPaginateAllPropagatesError(t *testing.T) {
+	wantErr := errors.New("boom")
+	_, err := PaginateAll(context.Background(), func(ctx context.Context, start string) ([]int, string, error) {
+		return nil, "", wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+}
+
+func TestPaginateUntilStopsAtMatch(t *testing.T) {
+	fetched := 0
+	pages := [][]int{{1, 2}, {3, 4}, {5}}
+
+	item, found, err := PaginateUntil(context.Background(), func(ctx context.Context, start string) ([]int, string, error) {
+		idx := 0
+		if start != "" {
+			idx = int(start[0] - 'a' + 1)
+		}
+		fetched++
+		next := ""
+		if idx+1 < len(pages) {
+			next = string(rune('a' + idx))
+		}
+		return pages[idx], next, nil
+	}, func(v int) bool { return v == 3 })
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !found || item != 3 {
+		t.Fatalf("expected to find 3, got %d (found=%v)", item, found)
+	}
+	if fetched != 2 {
+		t.Fatalf("expected to stop after 2 pages, fetched %d", fetched)
+	}
+}
+
+func TestPaginateUntilNoMatch(t *testing.T) {
+	_, found, err := PaginateUntil(context.Background(), func(ctx context.Context, start string) ([]int, string, error) {
+		return []int{1, 2}, "", nil
+	}, func(v int) bool { return v == 99 })
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if found {
+		t.Fatalf("expected no match")
+	}
+}
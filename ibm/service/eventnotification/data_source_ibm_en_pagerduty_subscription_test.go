@@ -8,9 +8,13 @@ import (
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/eventnotifications-go-admin-sdk/eventnotificationsv1"
 )
 
 func TestAccIBMEnPagerDutySubscriptionDataSourceAllArgs(t *testing.T) {
@@ -36,12 +40,82 @@ func TestAccIBMEnPagerDutySubscriptionDataSourceAllArgs(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_en_subscription_pagerduty.data_subscription_1", "destination_name"),
 					resource.TestCheckResourceAttrSet("data.ibm_en_subscription_pagerduty.data_subscription_1", "topic_id"),
 					resource.TestCheckResourceAttrSet("data.ibm_en_subscription_pagerduty.data_subscription_1", "topic_name"),
+					resource.TestCheckResourceAttr("data.ibm_en_subscription_pagerduty.data_subscription_1", "attributes.0.template_id_notification", "notification_template_1"),
+					resource.TestCheckResourceAttr("data.ibm_en_subscription_pagerduty.data_subscription_1", "attributes.0.signing_enabled", "true"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_subscription_pagerduty.data_subscription_1", "attributes.0.critical_routing_key"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_subscription_pagerduty.data_subscription_1", "attributes.0.warning_routing_key"),
+					resource.TestCheckResourceAttrSet("data.ibm_en_subscription_pagerduty.data_subscription_1", "attributes.0.info_routing_key"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccIBMEnPagerDutySubscriptionSigningEnabledUpdateInPlace(t *testing.T) {
+	var before, after eventnotificationsv1.SubscriptionResponse
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEnPagerDutySubscriptionResourceConfig(instanceName, name, description, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMEnPagerDutySubscriptionExists("ibm_en_subscription_pagerduty.en_subscription_resource_1", &before),
+					resource.TestCheckResourceAttr("ibm_en_subscription_pagerduty.en_subscription_resource_1", "attributes.0.signing_enabled", "false"),
+				),
+			},
+			{
+				// toggling signing_enabled is an in-place update, not a replacement
+				Config: testAccCheckIBMEnPagerDutySubscriptionResourceConfig(instanceName, name, description, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMEnPagerDutySubscriptionExists("ibm_en_subscription_pagerduty.en_subscription_resource_1", &after),
+					resource.TestCheckResourceAttr("ibm_en_subscription_pagerduty.en_subscription_resource_1", "attributes.0.signing_enabled", "true"),
+					testAccCheckIBMEnPagerDutySubscriptionSameID(&before, &after),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEnPagerDutySubscriptionExists(n string, obj *eventnotificationsv1.SubscriptionResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		enClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).EventNotificationsApiV1()
+		if err != nil {
+			return err
+		}
+
+		options := &eventnotificationsv1.GetSubscriptionOptions{}
+		options.SetInstanceID(rs.Primary.Attributes["instance_guid"])
+		options.SetID(rs.Primary.Attributes["subscription_id"])
+
+		result, _, err := enClient.GetSubscriptionWithContext(nil, options)
+		if err != nil {
+			return err
+		}
+
+		*obj = *result
+		return nil
+	}
+}
+
+func testAccCheckIBMEnPagerDutySubscriptionSameID(before, after *eventnotificationsv1.SubscriptionResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID == nil || after.ID == nil || *before.ID != *after.ID {
+			return fmt.Errorf("expected the subscription ID to stay the same across the update, got %v -> %v", before.ID, after.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIBMEnPagerDutySubscriptionDataSourceConfig(instanceName, name, description string) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "en_subscription_datasource" {
@@ -75,6 +149,13 @@ func testAccCheckIBMEnPagerDutySubscriptionDataSourceConfig(instanceName, name,
 		instance_guid    = ibm_resource_instance.en_subscription_resource.guid
 		topic_id       = ibm_en_topic.en_topic_resource_2.topic_id
 		destination_id = ibm_en_destination_pagerduty.en_destination_resource_2.destination_id
+		attributes {
+			template_id_notification = "notification_template_1"
+			signing_enabled          = true
+			critical_routing_key     = "critical_routing_key_value"
+			warning_routing_key      = "warning_routing_key_value"
+			info_routing_key         = "info_routing_key_value"
+		}
 	}
 
 	data "ibm_en_subscription_pagerduty" "data_subscription_1" {
@@ -84,3 +165,44 @@ func testAccCheckIBMEnPagerDutySubscriptionDataSourceConfig(instanceName, name,
 
 	`, instanceName, name, description)
 }
+
+func testAccCheckIBMEnPagerDutySubscriptionResourceConfig(instanceName, name, description string, signingEnabled bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "en_subscription_resource" {
+		name     = "%s"
+		location = "us-south"
+		plan     = "standard"
+		service  = "event-notifications"
+	}
+
+	resource "ibm_en_topic" "en_topic_resource_2" {
+		instance_guid = ibm_resource_instance.en_subscription_resource.guid
+		name          = "tf_topic_name_0664"
+		description   = "tf_topic_description_0455"
+	}
+
+	resource "ibm_en_destination_pagerduty" "en_destination_resource_2" {
+		instance_guid = ibm_resource_instance.en_subscription_resource.guid
+		name          = "pagerduty_destination"
+		type          = "pagerduty"
+		description   = "pagerduty destination tf"
+		config {
+			params {
+				routing_key = "33220320pgdpgpewwp"
+				api_key     = "dwvdouqufqwojji"
+			}
+		}
+	}
+
+	resource "ibm_en_subscription_pagerduty" "en_subscription_resource_1" {
+		name           = "%s"
+		description    = "%s"
+		instance_guid  = ibm_resource_instance.en_subscription_resource.guid
+		topic_id       = ibm_en_topic.en_topic_resource_2.topic_id
+		destination_id = ibm_en_destination_pagerduty.en_destination_resource_2.destination_id
+		attributes {
+			signing_enabled = %t
+		}
+	}
+	`, instanceName, name, description, signingEnabled)
+}
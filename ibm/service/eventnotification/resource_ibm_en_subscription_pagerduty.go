@@ -0,0 +1,344 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventnotification
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/eventnotifications-go-admin-sdk/eventnotificationsv1"
+)
+
+// parseEnSubscriptionID splits the Terraform ID of an Event Notifications
+// subscription resource into its instance GUID and subscription ID parts.
+func parseEnSubscriptionID(id string) (instanceID string, subscriptionID string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("incorrect ID %q, expected <instance-guid>/<subscription-id>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func ResourceIBMEnPagerDutySubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEnPagerDutySubscriptionCreate,
+		ReadContext:   resourceIBMEnPagerDutySubscriptionRead,
+		UpdateContext: resourceIBMEnPagerDutySubscriptionUpdate,
+		DeleteContext: resourceIBMEnPagerDutySubscriptionDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique identifier for IBM Cloud Event Notifications instance.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Subscription name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Subscription description.",
+			},
+			"destination_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The destination ID to associate with the subscription.",
+			},
+			"topic_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The topic ID to associate with the subscription.",
+			},
+			"attributes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Payload describing the PagerDuty subscription attributes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"template_id_notification": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the template used to render the PagerDuty notification.",
+						},
+						"signing_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to sign the outgoing webhook payload sent to PagerDuty.",
+						},
+						"critical_routing_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The PagerDuty routing key used for notifications of critical severity.",
+						},
+						"warning_routing_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The PagerDuty routing key used for notifications of warning severity.",
+						},
+						"info_routing_key": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The PagerDuty routing key used for notifications of info severity.",
+						},
+					},
+				},
+			},
+			"subscription_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subscription ID.",
+			},
+			"destination_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the destination associated with the subscription.",
+			},
+			"destination_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the destination associated with the subscription.",
+			},
+			"topic_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the topic associated with the subscription.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last updated time.",
+			},
+		},
+	}
+}
+
+func resourceIBMEnPagerDutySubscriptionAttributesMap(d *schema.ResourceData) map[string]interface{} {
+	attributesList, ok := d.GetOk("attributes")
+	if !ok {
+		return nil
+	}
+	raw := attributesList.([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	attributesMap := raw[0].(map[string]interface{})
+
+	result := map[string]interface{}{
+		"signing_enabled": attributesMap["signing_enabled"].(bool),
+	}
+	if v, ok := attributesMap["template_id_notification"].(string); ok && v != "" {
+		result["template_id_notification"] = v
+	}
+	if v, ok := attributesMap["critical_routing_key"].(string); ok && v != "" {
+		result["critical_routing_key"] = v
+	}
+	if v, ok := attributesMap["warning_routing_key"].(string); ok && v != "" {
+		result["warning_routing_key"] = v
+	}
+	if v, ok := attributesMap["info_routing_key"].(string); ok && v != "" {
+		result["info_routing_key"] = v
+	}
+	return result
+}
+
+func resourceIBMEnPagerDutySubscriptionAttributesToList(attributes map[string]interface{}) []map[string]interface{} {
+	if attributes == nil {
+		return []map[string]interface{}{}
+	}
+	modelMap := map[string]interface{}{}
+	if v, ok := attributes["template_id_notification"]; ok {
+		modelMap["template_id_notification"] = v
+	}
+	if v, ok := attributes["signing_enabled"]; ok {
+		modelMap["signing_enabled"] = v
+	}
+	if v, ok := attributes["critical_routing_key"]; ok {
+		modelMap["critical_routing_key"] = v
+	}
+	if v, ok := attributes["warning_routing_key"]; ok {
+		modelMap["warning_routing_key"] = v
+	}
+	if v, ok := attributes["info_routing_key"]; ok {
+		modelMap["info_routing_key"] = v
+	}
+	return []map[string]interface{}{modelMap}
+}
+
+func resourceIBMEnPagerDutySubscriptionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_en_subscription_pagerduty", "create", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	options := &eventnotificationsv1.CreateSubscriptionOptions{}
+	options.SetInstanceID(d.Get("instance_guid").(string))
+	options.SetName(d.Get("name").(string))
+	options.SetDestinationID(d.Get("destination_id").(string))
+	options.SetTopicID(d.Get("topic_id").(string))
+	options.SetDestinationType("pagerduty")
+	if v, ok := d.GetOk("description"); ok {
+		options.SetDescription(v.(string))
+	}
+	if attributes := resourceIBMEnPagerDutySubscriptionAttributesMap(d); attributes != nil {
+		options.SetAttributes(attributes)
+	}
+
+	result, _, err := enClient.CreateSubscriptionWithContext(context, options)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSubscriptionWithContext failed: %s", err.Error()), "ibm_en_subscription_pagerduty", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *options.InstanceID, *result.ID))
+
+	return resourceIBMEnPagerDutySubscriptionRead(context, d, meta)
+}
+
+func resourceIBMEnPagerDutySubscriptionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_en_subscription_pagerduty", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	instanceID, subscriptionID, err := parseEnSubscriptionID(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_en_subscription_pagerduty", "read", "parse-id").GetDiag()
+	}
+
+	options := &eventnotificationsv1.GetSubscriptionOptions{}
+	options.SetInstanceID(instanceID)
+	options.SetID(subscriptionID)
+
+	result, response, err := enClient.GetSubscriptionWithContext(context, options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetSubscriptionWithContext failed: %s", err.Error()), "ibm_en_subscription_pagerduty", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if err = d.Set("instance_guid", instanceID); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting instance_guid: %s", err), "ibm_en_subscription_pagerduty", "read", "set-instance_guid").GetDiag()
+	}
+	if err = d.Set("subscription_id", result.ID); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting subscription_id: %s", err), "ibm_en_subscription_pagerduty", "read", "set-subscription_id").GetDiag()
+	}
+	if err = d.Set("name", result.Name); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting name: %s", err), "ibm_en_subscription_pagerduty", "read", "set-name").GetDiag()
+	}
+	if err = d.Set("description", result.Description); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting description: %s", err), "ibm_en_subscription_pagerduty", "read", "set-description").GetDiag()
+	}
+	if err = d.Set("destination_id", result.DestinationID); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting destination_id: %s", err), "ibm_en_subscription_pagerduty", "read", "set-destination_id").GetDiag()
+	}
+	if err = d.Set("destination_name", result.DestinationName); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting destination_name: %s", err), "ibm_en_subscription_pagerduty", "read", "set-destination_name").GetDiag()
+	}
+	if err = d.Set("destination_type", result.DestinationType); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting destination_type: %s", err), "ibm_en_subscription_pagerduty", "read", "set-destination_type").GetDiag()
+	}
+	if err = d.Set("topic_id", result.TopicID); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting topic_id: %s", err), "ibm_en_subscription_pagerduty", "read", "set-topic_id").GetDiag()
+	}
+	if err = d.Set("topic_name", result.TopicName); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting topic_name: %s", err), "ibm_en_subscription_pagerduty", "read", "set-topic_name").GetDiag()
+	}
+	if err = d.Set("updated_at", flex.DateTimeToString(result.UpdatedAt)); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting updated_at: %s", err), "ibm_en_subscription_pagerduty", "read", "set-updated_at").GetDiag()
+	}
+	if err = d.Set("attributes", resourceIBMEnPagerDutySubscriptionAttributesToList(result.Attributes)); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting attributes: %s", err), "ibm_en_subscription_pagerduty", "read", "set-attributes").GetDiag()
+	}
+
+	return nil
+}
+
+func resourceIBMEnPagerDutySubscriptionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_en_subscription_pagerduty", "update", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	instanceID, subscriptionID, err := parseEnSubscriptionID(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_en_subscription_pagerduty", "update", "parse-id").GetDiag()
+	}
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("attributes") {
+		options := &eventnotificationsv1.UpdateSubscriptionOptions{}
+		options.SetInstanceID(instanceID)
+		options.SetID(subscriptionID)
+		options.SetName(d.Get("name").(string))
+		if v, ok := d.GetOk("description"); ok {
+			options.SetDescription(v.(string))
+		}
+		if attributes := resourceIBMEnPagerDutySubscriptionAttributesMap(d); attributes != nil {
+			options.SetAttributes(attributes)
+		}
+
+		_, _, err = enClient.UpdateSubscriptionWithContext(context, options)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateSubscriptionWithContext failed: %s", err.Error()), "ibm_en_subscription_pagerduty", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	return resourceIBMEnPagerDutySubscriptionRead(context, d, meta)
+}
+
+func resourceIBMEnPagerDutySubscriptionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enClient, err := meta.(conns.ClientSession).EventNotificationsApiV1()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_en_subscription_pagerduty", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	instanceID, subscriptionID, err := parseEnSubscriptionID(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_en_subscription_pagerduty", "delete", "parse-id").GetDiag()
+	}
+
+	options := &eventnotificationsv1.DeleteSubscriptionOptions{}
+	options.SetInstanceID(instanceID)
+	options.SetID(subscriptionID)
+
+	_, err = enClient.DeleteSubscriptionWithContext(context, options)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSubscriptionWithContext failed: %s", err.Error()), "ibm_en_subscription_pagerduty", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+	return nil
+}
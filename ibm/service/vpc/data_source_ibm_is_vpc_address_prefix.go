@@ -112,39 +112,31 @@ func dataSourceIBMIsVPCAddressPrefixRead(context context.Context, d *schema.Reso
 	vpc_name := d.Get("vpc_name").(string)
 	var addressPrefix *vpcv1.AddressPrefix
 	if vpc_id == "" {
-		start := ""
-		allrecs := []vpcv1.VPC{}
-		for {
+		vpc, found, err := flex.PaginateUntil(context, func(context context.Context, start string) ([]vpcv1.VPC, string, error) {
 			listVpcsOptions := &vpcv1.ListVpcsOptions{}
 			if start != "" {
 				listVpcsOptions.Start = &start
 			}
 			vpcs, _, err := vpcClient.ListVpcsWithContext(context, listVpcsOptions)
 			if err != nil {
-				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVpcsWithContext failed: %s", err.Error()), "(Data) ibm_is_vpc_address_prefix", "read")
-				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-				return tfErr.GetDiag()
-			}
-			start = flex.GetNext(vpcs.Next)
-			allrecs = append(allrecs, vpcs.Vpcs...)
-			if start == "" {
-				break
-			}
-		}
-		vpc_found := false
-		for _, vpc := range allrecs {
-			if *vpc.Name == vpc_name {
-				vpc_id = *vpc.ID
-				vpc_found = true
-				break
+				return nil, "", err
 			}
+			return vpcs.Vpcs, flex.GetNext(vpcs.Next), nil
+		}, func(vpc vpcv1.VPC) bool {
+			return vpc.Name != nil && *vpc.Name == vpc_name
+		})
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVpcsWithContext failed: %s", err.Error()), "(Data) ibm_is_vpc_address_prefix", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
 		}
-		if !vpc_found {
+		if !found {
 			err = fmt.Errorf("VPC with given name not found %s", vpc_name)
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVpcsWithContext failed: %s", err.Error()), "(Data) ibm_is_vpc_address_prefix", "read")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
+		vpc_id = *vpc.ID
 	}
 	if address_prefix_id != "" {
 		getVPCAddressPrefixOptions := &vpcv1.GetVPCAddressPrefixOptions{}
@@ -161,34 +153,25 @@ func dataSourceIBMIsVPCAddressPrefixRead(context context.Context, d *schema.Reso
 		addressPrefix = addressPrefix1
 
 	} else {
-		start := ""
-		allrecs := []vpcv1.AddressPrefix{}
 		listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{}
-
 		listVpcAddressPrefixesOptions.SetVPCID(vpc_id)
-		for {
+
+		found, address_prefix_found, err := flex.PaginateUntil(context, func(context context.Context, start string) ([]vpcv1.AddressPrefix, string, error) {
 			if start != "" {
 				listVpcAddressPrefixesOptions.Start = &start
 			}
 			addressPrefixCollection, _, err := vpcClient.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
 			if err != nil {
-				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPCAddressPrefixesWithContext failed: %s", err.Error()), "(Data) ibm_is_vpc_address_prefix", "read")
-				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-				return tfErr.GetDiag()
-			}
-			start = flex.GetNext(addressPrefixCollection.Next)
-			allrecs = append(allrecs, addressPrefixCollection.AddressPrefixes...)
-			if start == "" {
-				break
-			}
-		}
-		address_prefix_found := false
-		for _, addressPrefixItem := range allrecs {
-			if *addressPrefixItem.Name == address_prefix_name {
-				addressPrefix = &addressPrefixItem
-				address_prefix_found = true
-				break
+				return nil, "", err
 			}
+			return addressPrefixCollection.AddressPrefixes, flex.GetNext(addressPrefixCollection.Next), nil
+		}, func(addressPrefixItem vpcv1.AddressPrefix) bool {
+			return addressPrefixItem.Name != nil && *addressPrefixItem.Name == address_prefix_name
+		})
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPCAddressPrefixesWithContext failed: %s", err.Error()), "(Data) ibm_is_vpc_address_prefix", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
 		}
 		if !address_prefix_found {
 			err = fmt.Errorf("Address Prefix with given name not found %s", address_prefix_name)
@@ -196,6 +179,7 @@ func dataSourceIBMIsVPCAddressPrefixRead(context context.Context, d *schema.Reso
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
 		}
+		addressPrefix = &found
 	}
 	d.SetId(*addressPrefix.ID)
 	if err = d.Set("cidr", addressPrefix.CIDR); err != nil {
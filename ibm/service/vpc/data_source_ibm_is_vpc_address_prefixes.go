@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func DataSourceIBMIsVPCAddressPrefixes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPCAddressPrefixesRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPC identifier.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the collection to address prefixes in the zone with the exact specified name.",
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Filters the collection to address prefixes with the specified is_default value.",
+			},
+			"cidr_contains": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the collection to address prefixes whose CIDR block contains the specified CIDR.",
+			},
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the collection to address prefixes whose name begins with the specified prefix.",
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of address prefixes matching the filters.",
+			},
+			"address_prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Collection of address prefixes for the VPC.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for this address prefix.",
+						},
+						"cidr": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CIDR block for this prefix.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The user-defined name for this address prefix.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The globally unique name of the zone this address prefix resides in.",
+						},
+						"has_subnets": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether subnets exist with addresses from this prefix.",
+						},
+						"is_default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether this is the default prefix for this zone in this VPC.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIsVPCAddressPrefixesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_prefixes", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	vpcID := d.Get("vpc").(string)
+	zone := d.Get("zone").(string)
+	namePrefix := d.Get("name_prefix").(string)
+	cidrContains := d.Get("cidr_contains").(string)
+	_, isDefaultSet := d.GetOkExists("is_default")
+	isDefault := d.Get("is_default").(bool)
+
+	var cidrContainsNet *net.IPNet
+	if cidrContains != "" {
+		_, parsed, err := net.ParseCIDR(cidrContains)
+		if err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error parsing cidr_contains %q: %s", cidrContains, err), "(Data) ibm_is_vpc_address_prefixes", "read", "parse-cidr_contains").GetDiag()
+		}
+		cidrContainsNet = parsed
+	}
+
+	listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{}
+	listVpcAddressPrefixesOptions.SetVPCID(vpcID)
+
+	allAddressPrefixes, err := flex.PaginateAll(context, func(context context.Context, start string) ([]vpcv1.AddressPrefix, string, error) {
+		if start != "" {
+			listVpcAddressPrefixesOptions.Start = &start
+		}
+		addressPrefixCollection, _, err := vpcClient.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
+		if err != nil {
+			return nil, "", err
+		}
+		return addressPrefixCollection.AddressPrefixes, flex.GetNext(addressPrefixCollection.Next), nil
+	})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPCAddressPrefixesWithContext failed: %s", err.Error()), "(Data) ibm_is_vpc_address_prefixes", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	matched := []vpcv1.AddressPrefix{}
+	for _, addressPrefixItem := range allAddressPrefixes {
+		if zone != "" && (addressPrefixItem.Zone == nil || addressPrefixItem.Zone.Name == nil || *addressPrefixItem.Zone.Name != zone) {
+			continue
+		}
+		if isDefaultSet && (addressPrefixItem.IsDefault == nil || *addressPrefixItem.IsDefault != isDefault) {
+			continue
+		}
+		if cidrContainsNet != nil {
+			if addressPrefixItem.CIDR == nil {
+				continue
+			}
+			_, prefixNet, err := net.ParseCIDR(*addressPrefixItem.CIDR)
+			if err != nil || !cidrNetworkContains(prefixNet, cidrContainsNet) {
+				continue
+			}
+		}
+		if namePrefix != "" && (addressPrefixItem.Name == nil || !strings.HasPrefix(*addressPrefixItem.Name, namePrefix)) {
+			continue
+		}
+		matched = append(matched, addressPrefixItem)
+	}
+
+	d.SetId(fmt.Sprintf("%s/address-prefixes", vpcID))
+
+	addressPrefixes := make([]map[string]interface{}, 0, len(matched))
+	for _, addressPrefixItem := range matched {
+		modelMap, err := dataSourceIBMIsVPCAddressPrefixesAddressPrefixToMap(&addressPrefixItem)
+		if err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc_address_prefixes", "read", "address-prefix-to-map").GetDiag()
+		}
+		addressPrefixes = append(addressPrefixes, modelMap)
+	}
+
+	if err = d.Set("address_prefixes", addressPrefixes); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting address_prefixes: %s", err), "(Data) ibm_is_vpc_address_prefixes", "read", "set-address_prefixes").GetDiag()
+	}
+
+	if err = d.Set("total_count", len(addressPrefixes)); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting total_count: %s", err), "(Data) ibm_is_vpc_address_prefixes", "read", "set-total_count").GetDiag()
+	}
+
+	return nil
+}
+
+// cidrNetworkContains reports whether prefixNet, as a network, contains
+// filterNet in its entirety (i.e. filterNet is the same network or a more
+// specific subnet of it), not merely whether one CIDR string is a substring
+// of the other.
+func cidrNetworkContains(prefixNet, filterNet *net.IPNet) bool {
+	prefixOnes, prefixBits := prefixNet.Mask.Size()
+	filterOnes, filterBits := filterNet.Mask.Size()
+	if prefixBits != filterBits {
+		return false
+	}
+	if filterOnes < prefixOnes {
+		return false
+	}
+	return prefixNet.Contains(filterNet.IP)
+}
+
+func dataSourceIBMIsVPCAddressPrefixesAddressPrefixToMap(model *vpcv1.AddressPrefix) (map[string]interface{}, error) {
+	modelMap := make(map[string]interface{})
+	if model.ID != nil {
+		modelMap["id"] = *model.ID
+	}
+	if model.CIDR != nil {
+		modelMap["cidr"] = *model.CIDR
+	}
+	if model.Name != nil {
+		modelMap["name"] = *model.Name
+	}
+	if model.Zone != nil && model.Zone.Name != nil {
+		modelMap["zone"] = *model.Zone.Name
+	}
+	if model.HasSubnets != nil {
+		modelMap["has_subnets"] = *model.HasSubnets
+	}
+	if model.IsDefault != nil {
+		modelMap["is_default"] = *model.IsDefault
+	}
+	return modelMap, nil
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// These fixtures rely only on the VPC's automatically-created default
+// address prefixes (one per zone) rather than the ibm_is_vpc_address_prefix
+// managed resource, so this data source is tested independently of that
+// resource.
+
+func TestAccIBMIsVPCAddressPrefixesDataSourceNamePrefix(t *testing.T) {
+	vpcName := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPCAddressPrefixesDataSourceNamePrefixConfig(vpcName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpc_address_prefixes.filtered", "total_count"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_vpc_address_prefixes.filtered", "address_prefixes.0.name", "data.ibm_is_vpc_address_prefixes.all", "address_prefixes.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsVPCAddressPrefixesDataSourceZone(t *testing.T) {
+	vpcName := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPCAddressPrefixesDataSourceZoneConfig(vpcName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpc_address_prefixes.filtered", "total_count"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_vpc_address_prefixes.filtered", "address_prefixes.0.zone", "data.ibm_is_vpc_address_prefixes.all", "address_prefixes.0.zone"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsVPCAddressPrefixesDataSourceCIDRContains(t *testing.T) {
+	vpcName := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPCAddressPrefixesDataSourceCIDRContainsConfig(vpcName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_vpc_address_prefixes.filtered", "address_prefixes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_is_vpc_address_prefixes.filtered", "address_prefixes.0.cidr", "data.ibm_is_vpc_address_prefixes.all", "address_prefixes.0.cidr"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPCAddressPrefixesDataSourceNamePrefixConfig(vpcName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test" {
+		name = "%s"
+	}
+
+	data "ibm_is_vpc_address_prefixes" "all" {
+		vpc = ibm_is_vpc.test.id
+	}
+
+	data "ibm_is_vpc_address_prefixes" "filtered" {
+		vpc         = ibm_is_vpc.test.id
+		name_prefix = substr(data.ibm_is_vpc_address_prefixes.all.address_prefixes[0].name, 0, 4)
+	}
+	`, vpcName)
+}
+
+func testAccCheckIBMIsVPCAddressPrefixesDataSourceZoneConfig(vpcName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test" {
+		name = "%s"
+	}
+
+	data "ibm_is_vpc_address_prefixes" "all" {
+		vpc = ibm_is_vpc.test.id
+	}
+
+	data "ibm_is_vpc_address_prefixes" "filtered" {
+		vpc  = ibm_is_vpc.test.id
+		zone = data.ibm_is_vpc_address_prefixes.all.address_prefixes[0].zone
+	}
+	`, vpcName)
+}
+
+func testAccCheckIBMIsVPCAddressPrefixesDataSourceCIDRContainsConfig(vpcName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test" {
+		name = "%s"
+	}
+
+	data "ibm_is_vpc_address_prefixes" "all" {
+		vpc = ibm_is_vpc.test.id
+	}
+
+	data "ibm_is_vpc_address_prefixes" "filtered" {
+		vpc           = ibm_is_vpc.test.id
+		cidr_contains = cidrsubnet(data.ibm_is_vpc_address_prefixes.all.address_prefixes[0].cidr, 1, 0)
+	}
+	`, vpcName)
+}
@@ -0,0 +1,328 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func ResourceIBMIsVPCAddressPrefix() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIsVPCAddressPrefixCreate,
+		ReadContext:   resourceIBMIsVPCAddressPrefixRead,
+		UpdateContext: resourceIBMIsVPCAddressPrefixUpdate,
+		DeleteContext: resourceIBMIsVPCAddressPrefixDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.All(
+			resourceIBMIsVPCAddressPrefixOverlapValidate,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"vpc": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The VPC identifier.",
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the zone this address prefix resides in.",
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CIDR block for this address prefix.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The user-defined name for this address prefix.",
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether this is the default prefix for this zone in this VPC.",
+			},
+			"has_subnets": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether subnets exist with addresses from this prefix.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the prefix was created.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this address prefix.",
+			},
+		},
+	}
+}
+
+// resourceIBMIsVPCAddressPrefixOverlapValidate lists the address prefixes
+// already attached to the target VPC at plan time and rejects the diff if
+// the configured CIDR overlaps with one of them, instead of letting the
+// overlap surface as a 409 from the API mid-apply. Both cidr and zone are
+// ForceNew, so either changing can recreate the prefix; re-validate on
+// either change. While cidr or vpc is not yet known (for example when the
+// VPC is created in the same apply), the check is skipped for that plan.
+func resourceIBMIsVPCAddressPrefixOverlapValidate(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("cidr") && !diff.HasChange("zone") && diff.Id() != "" {
+		return nil
+	}
+	cidrRaw, ok := diff.GetOk("cidr")
+	if !ok {
+		return nil
+	}
+	vpcID, ok := diff.GetOk("vpc")
+	if !ok {
+		return nil
+	}
+
+	_, newNet, err := net.ParseCIDR(cidrRaw.(string))
+	if err != nil {
+		return fmt.Errorf("invalid cidr %q: %s", cidrRaw.(string), err)
+	}
+
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+
+	listVpcAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{}
+	listVpcAddressPrefixesOptions.SetVPCID(vpcID.(string))
+
+	existingPrefixes, err := flex.PaginateAll(context, func(context context.Context, start string) ([]vpcv1.AddressPrefix, string, error) {
+		if start != "" {
+			listVpcAddressPrefixesOptions.Start = &start
+		}
+		addressPrefixCollection, _, err := vpcClient.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
+		if err != nil {
+			return nil, "", err
+		}
+		return addressPrefixCollection.AddressPrefixes, flex.GetNext(addressPrefixCollection.Next), nil
+	})
+	if err != nil {
+		return fmt.Errorf("ListVPCAddressPrefixesWithContext failed while checking for overlap: %s", err)
+	}
+
+	_, ownPrefixID, _ := resourceIBMIsVPCAddressPrefixParseID(diff.Id())
+	for _, existing := range existingPrefixes {
+		if existing.ID != nil && ownPrefixID != "" && *existing.ID == ownPrefixID {
+			continue
+		}
+		if existing.CIDR == nil {
+			continue
+		}
+		_, existingNet, err := net.ParseCIDR(*existing.CIDR)
+		if err != nil {
+			continue
+		}
+		if cidrsOverlap(newNet, existingNet) {
+			existingName := ""
+			if existing.Name != nil {
+				existingName = *existing.Name
+			}
+			return fmt.Errorf("cidr %q overlaps with existing address prefix %q (%s) in vpc %q", cidrRaw.(string), existingName, *existing.CIDR, vpcID.(string))
+		}
+	}
+
+	return nil
+}
+
+func cidrsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func resourceIBMIsVPCAddressPrefixCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_address_prefix", "create", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	vpcID := d.Get("vpc").(string)
+	createVPCAddressPrefixOptions := &vpcv1.CreateVPCAddressPrefixOptions{}
+	createVPCAddressPrefixOptions.SetVPCID(vpcID)
+	createVPCAddressPrefixOptions.SetCIDR(d.Get("cidr").(string))
+	createVPCAddressPrefixOptions.SetZone(&vpcv1.ZoneIdentity{
+		Name: core.StringPtr(d.Get("zone").(string)),
+	})
+	if v, ok := d.GetOk("name"); ok {
+		createVPCAddressPrefixOptions.SetName(v.(string))
+	}
+	if v, ok := d.GetOkExists("is_default"); ok {
+		createVPCAddressPrefixOptions.SetIsDefault(v.(bool))
+	}
+
+	addressPrefix, _, err := vpcClient.CreateVPCAddressPrefixWithContext(context, createVPCAddressPrefixOptions)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateVPCAddressPrefixWithContext failed: %s", err.Error()), "ibm_is_vpc_address_prefix", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", vpcID, *addressPrefix.ID))
+
+	return resourceIBMIsVPCAddressPrefixRead(context, d, meta)
+}
+
+func resourceIBMIsVPCAddressPrefixRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_address_prefix", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	vpcID, addressPrefixID, err := resourceIBMIsVPCAddressPrefixParseID(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_address_prefix", "read", "parse-id").GetDiag()
+	}
+
+	getVPCAddressPrefixOptions := &vpcv1.GetVPCAddressPrefixOptions{}
+	getVPCAddressPrefixOptions.SetVPCID(vpcID)
+	getVPCAddressPrefixOptions.SetID(addressPrefixID)
+
+	addressPrefix, response, err := vpcClient.GetVPCAddressPrefixWithContext(context, getVPCAddressPrefixOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetVPCAddressPrefixWithContext failed: %s", err.Error()), "ibm_is_vpc_address_prefix", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if err = d.Set("vpc", vpcID); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting vpc: %s", err), "ibm_is_vpc_address_prefix", "read", "set-vpc").GetDiag()
+	}
+	if err = d.Set("cidr", addressPrefix.CIDR); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting cidr: %s", err), "ibm_is_vpc_address_prefix", "read", "set-cidr").GetDiag()
+	}
+	if addressPrefix.Zone != nil && addressPrefix.Zone.Name != nil {
+		if err = d.Set("zone", *addressPrefix.Zone.Name); err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting zone: %s", err), "ibm_is_vpc_address_prefix", "read", "set-zone").GetDiag()
+		}
+	}
+	if err = d.Set("name", addressPrefix.Name); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting name: %s", err), "ibm_is_vpc_address_prefix", "read", "set-name").GetDiag()
+	}
+	if err = d.Set("is_default", addressPrefix.IsDefault); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting is_default: %s", err), "ibm_is_vpc_address_prefix", "read", "set-is_default").GetDiag()
+	}
+	if err = d.Set("has_subnets", addressPrefix.HasSubnets); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting has_subnets: %s", err), "ibm_is_vpc_address_prefix", "read", "set-has_subnets").GetDiag()
+	}
+	if err = d.Set("created_at", flex.DateTimeToString(addressPrefix.CreatedAt)); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting created_at: %s", err), "ibm_is_vpc_address_prefix", "read", "set-created_at").GetDiag()
+	}
+	if err = d.Set("href", addressPrefix.Href); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting href: %s", err), "ibm_is_vpc_address_prefix", "read", "set-href").GetDiag()
+	}
+
+	return nil
+}
+
+func resourceIBMIsVPCAddressPrefixUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_address_prefix", "update", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	vpcID, addressPrefixID, err := resourceIBMIsVPCAddressPrefixParseID(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_address_prefix", "update", "parse-id").GetDiag()
+	}
+
+	if d.HasChange("name") || d.HasChange("is_default") {
+		updateVPCAddressPrefixOptions := &vpcv1.UpdateVPCAddressPrefixOptions{}
+		updateVPCAddressPrefixOptions.SetVPCID(vpcID)
+		updateVPCAddressPrefixOptions.SetID(addressPrefixID)
+
+		addressPrefixPatchModel := &vpcv1.AddressPrefixPatch{}
+		if d.HasChange("name") {
+			addressPrefixPatchModel.Name = core.StringPtr(d.Get("name").(string))
+		}
+		if d.HasChange("is_default") {
+			addressPrefixPatchModel.IsDefault = core.BoolPtr(d.Get("is_default").(bool))
+		}
+		addressPrefixPatch, err := addressPrefixPatchModel.AsPatch()
+		if err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error calling AsPatch for AddressPrefixPatch: %s", err), "ibm_is_vpc_address_prefix", "update", "address-prefix-patch").GetDiag()
+		}
+		updateVPCAddressPrefixOptions.AddressPrefixPatch = addressPrefixPatch
+
+		_, _, err = vpcClient.UpdateVPCAddressPrefixWithContext(context, updateVPCAddressPrefixOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateVPCAddressPrefixWithContext failed: %s", err.Error()), "ibm_is_vpc_address_prefix", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	return resourceIBMIsVPCAddressPrefixRead(context, d, meta)
+}
+
+func resourceIBMIsVPCAddressPrefixDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_address_prefix", "delete", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	vpcID, addressPrefixID, err := resourceIBMIsVPCAddressPrefixParseID(d.Id())
+	if err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc_address_prefix", "delete", "parse-id").GetDiag()
+	}
+
+	deleteVPCAddressPrefixOptions := &vpcv1.DeleteVPCAddressPrefixOptions{}
+	deleteVPCAddressPrefixOptions.SetVPCID(vpcID)
+	deleteVPCAddressPrefixOptions.SetID(addressPrefixID)
+
+	_, err = vpcClient.DeleteVPCAddressPrefixWithContext(context, deleteVPCAddressPrefixOptions)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteVPCAddressPrefixWithContext failed: %s", err.Error()), "ibm_is_vpc_address_prefix", "delete")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMIsVPCAddressPrefixParseID(id string) (vpcID string, addressPrefixID string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("incorrect ID %q, expected <vpc-id>/<prefix-id>", id)
+	}
+	return parts[0], parts[1], nil
+}
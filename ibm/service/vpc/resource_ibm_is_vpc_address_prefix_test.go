@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+var regexpMustCompileOverlap = regexp.MustCompile(`overlaps with existing address prefix`)
+
+func TestAccIBMIsVPCAddressPrefixBasic(t *testing.T) {
+	var addressPrefix vpcv1.AddressPrefix
+	vpcName := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	prefixName := fmt.Sprintf("tf-prefix-%d", acctest.RandIntRange(10, 100))
+	prefixNameUpdated := fmt.Sprintf("%s-updated", prefixName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsVPCAddressPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPCAddressPrefixConfig(vpcName, prefixName, "10.241.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMIsVPCAddressPrefixExists("ibm_is_vpc_address_prefix.test", &addressPrefix),
+					resource.TestCheckResourceAttr("ibm_is_vpc_address_prefix.test", "name", prefixName),
+					resource.TestCheckResourceAttr("ibm_is_vpc_address_prefix.test", "cidr", "10.241.0.0/24"),
+				),
+			},
+			{
+				// renaming a prefix is an in-place update, not a replacement
+				Config: testAccCheckIBMIsVPCAddressPrefixConfig(vpcName, prefixNameUpdated, "10.241.0.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMIsVPCAddressPrefixExists("ibm_is_vpc_address_prefix.test", &addressPrefix),
+					resource.TestCheckResourceAttr("ibm_is_vpc_address_prefix.test", "name", prefixNameUpdated),
+				),
+			},
+			{
+				ResourceName:      "ibm_is_vpc_address_prefix.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMIsVPCAddressPrefixDefaultRename(t *testing.T) {
+	var before, after vpcv1.AddressPrefix
+	vpcName := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	prefixName := fmt.Sprintf("tf-default-prefix-%d", acctest.RandIntRange(10, 100))
+	prefixNameUpdated := fmt.Sprintf("%s-renamed", prefixName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsVPCAddressPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPCAddressPrefixDefaultConfig(vpcName, prefixName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMIsVPCAddressPrefixExists("ibm_is_vpc_address_prefix.test", &before),
+					resource.TestCheckResourceAttr("ibm_is_vpc_address_prefix.test", "name", prefixName),
+					resource.TestCheckResourceAttr("ibm_is_vpc_address_prefix.test", "is_default", "true"),
+				),
+			},
+			{
+				// renaming the VPC's default prefix must update it in place, not replace it
+				Config: testAccCheckIBMIsVPCAddressPrefixDefaultConfig(vpcName, prefixNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMIsVPCAddressPrefixExists("ibm_is_vpc_address_prefix.test", &after),
+					resource.TestCheckResourceAttr("ibm_is_vpc_address_prefix.test", "name", prefixNameUpdated),
+					resource.TestCheckResourceAttr("ibm_is_vpc_address_prefix.test", "is_default", "true"),
+					testAccCheckIBMIsVPCAddressPrefixSameID(&before, &after),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIsVPCAddressPrefixOverlap(t *testing.T) {
+	vpcName := fmt.Sprintf("tf-vpc-%d", acctest.RandIntRange(10, 100))
+	prefixName := fmt.Sprintf("tf-prefix-%d", acctest.RandIntRange(10, 100))
+	overlappingName := fmt.Sprintf("tf-prefix-overlap-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIsVPCAddressPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMIsVPCAddressPrefixOverlapConfig(vpcName, prefixName, overlappingName),
+				ExpectError: regexpMustCompileOverlap,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPCAddressPrefixExists(n string, obj *vpcv1.AddressPrefix) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		vpcID, addressPrefixID, err := splitVPCAddressPrefixTestID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return err
+		}
+
+		getVPCAddressPrefixOptions := &vpcv1.GetVPCAddressPrefixOptions{}
+		getVPCAddressPrefixOptions.SetVPCID(vpcID)
+		getVPCAddressPrefixOptions.SetID(addressPrefixID)
+
+		addressPrefix, _, err := vpcClient.GetVPCAddressPrefixWithContext(nil, getVPCAddressPrefixOptions)
+		if err != nil {
+			return err
+		}
+
+		*obj = *addressPrefix
+		return nil
+	}
+}
+
+func testAccCheckIBMIsVPCAddressPrefixSameID(before, after *vpcv1.AddressPrefix) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID == nil || after.ID == nil || *before.ID != *after.ID {
+			return fmt.Errorf("expected the address prefix ID to stay the same across the rename, got %v -> %v", before.ID, after.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIsVPCAddressPrefixDestroy(s *terraform.State) error {
+	vpcClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_is_vpc_address_prefix" {
+			continue
+		}
+
+		vpcID, addressPrefixID, err := splitVPCAddressPrefixTestID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		getVPCAddressPrefixOptions := &vpcv1.GetVPCAddressPrefixOptions{}
+		getVPCAddressPrefixOptions.SetVPCID(vpcID)
+		getVPCAddressPrefixOptions.SetID(addressPrefixID)
+
+		_, response, err := vpcClient.GetVPCAddressPrefixWithContext(nil, getVPCAddressPrefixOptions)
+		if err == nil {
+			return fmt.Errorf("address prefix still exists: %s", rs.Primary.ID)
+		}
+		if response == nil || response.StatusCode != 404 {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIsVPCAddressPrefixConfig(vpcName, prefixName, cidr string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test" {
+		name = "%s"
+	}
+
+	resource "ibm_is_vpc_address_prefix" "test" {
+		name = "%s"
+		vpc  = ibm_is_vpc.test.id
+		zone = "%s-1"
+		cidr = "%s"
+	}
+	`, vpcName, prefixName, acc.ISRegionName, cidr)
+}
+
+func testAccCheckIBMIsVPCAddressPrefixDefaultConfig(vpcName, prefixName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test" {
+		name                    = "%s"
+		default_address_prefix  = "manual"
+	}
+
+	resource "ibm_is_vpc_address_prefix" "test" {
+		name       = "%s"
+		vpc        = ibm_is_vpc.test.id
+		zone       = "%s-1"
+		cidr       = "10.243.0.0/24"
+		is_default = true
+	}
+	`, vpcName, prefixName, acc.ISRegionName)
+}
+
+func testAccCheckIBMIsVPCAddressPrefixOverlapConfig(vpcName, prefixName, overlappingName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "test" {
+		name = "%s"
+	}
+
+	resource "ibm_is_vpc_address_prefix" "test" {
+		name = "%s"
+		vpc  = ibm_is_vpc.test.id
+		zone = "%s-1"
+		cidr = "10.242.0.0/24"
+	}
+
+	resource "ibm_is_vpc_address_prefix" "overlap" {
+		name = "%s"
+		vpc  = ibm_is_vpc.test.id
+		zone = "%s-1"
+		cidr = "10.242.0.0/25"
+
+		depends_on = [ibm_is_vpc_address_prefix.test]
+	}
+	`, vpcName, prefixName, acc.ISRegionName, overlappingName, acc.ISRegionName)
+}
+
+func splitVPCAddressPrefixTestID(id string) (vpcID string, addressPrefixID string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("incorrect ID %q, expected <vpc-id>/<prefix-id>", id)
+	}
+	return parts[0], parts[1], nil
+}